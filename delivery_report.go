@@ -0,0 +1,121 @@
+package smscountry
+
+import (
+	"context"
+	"fmt"
+	"io/ioutil"
+	"net/http"
+	"net/url"
+	"strings"
+	"time"
+)
+
+// Defines the keys used when querying the bulk delivery report endpoint
+const (
+	JobID = "jobid"
+)
+
+// dateTimeLayout is the timestamp format SMS country uses in delivery reports
+const dateTimeLayout = "02-01-2006 15:04:05"
+
+// DeliveryStatus represents the delivery status of a single message as
+// reported by the bulk delivery report endpoint
+type DeliveryStatus struct {
+	MobileNumber     string
+	StatusCode       string
+	ErrorDescription string
+	SentTime         time.Time
+	DeliveredTime    time.Time
+}
+
+// FetchDeliveryReport retrieves the delivery status of every message
+// submitted under jobID by querying BulkReportPath
+func (s Client) FetchDeliveryReport(jobID string) (statuses []DeliveryStatus, err error) {
+	return s.FetchDeliveryReportContext(context.Background(), jobID)
+}
+
+// FetchDeliveryReportContext is like FetchDeliveryReport but observes ctx
+// cancellation, both for the request itself and between retry attempts,
+// and retries per the Client's configured Retryer
+func (s Client) FetchDeliveryReportContext(ctx context.Context, jobID string) (statuses []DeliveryStatus, err error) {
+	reportURL := (&url.URL{
+		Host:   s.host,
+		Path:   BulkReportPath,
+		Scheme: s.scheme,
+		RawQuery: url.Values{
+			User:     {s.User},
+			Password: {s.Password},
+			JobID:    {jobID},
+		}.Encode(),
+	}).String()
+	err = retryDo(ctx, s.retryer, func() (ferr error) {
+		req, er := http.NewRequestWithContext(ctx, http.MethodGet, reportURL, nil)
+		if er != nil {
+			return er
+		}
+		resp, er := s.httpClient.Do(req)
+		if er != nil {
+			return er
+		}
+		if resp.Body == nil {
+			return ErrEmptyResponse
+		}
+		defer func() {
+			if cerr := resp.Body.Close(); cerr != nil {
+				if ferr == nil {
+					ferr = cerr
+				} else {
+					ferr = fmt.Errorf("Error: %v, Body close error: %v", ferr, cerr)
+				}
+			}
+		}()
+		if resp.StatusCode >= http.StatusInternalServerError {
+			return &httpStatusError{StatusCode: resp.StatusCode}
+		}
+		r, er := ioutil.ReadAll(resp.Body)
+		if er != nil {
+			return er
+		}
+		parsed, er := parseDeliveryReport(string(r))
+		if er != nil {
+			return er
+		}
+		statuses = parsed
+		return nil
+	})
+	return statuses, err
+}
+
+// parseDeliveryReport parses the CSV-ish response returned by
+// BulkReportPath, one line per message in the form
+// mobilenumber,statuscode,errordescription,senttime,deliveredtime
+func parseDeliveryReport(response string) ([]DeliveryStatus, error) {
+	lines := strings.Split(strings.TrimSpace(response), "\n")
+	statuses := make([]DeliveryStatus, 0, len(lines))
+	for _, line := range lines {
+		line = strings.TrimSpace(line)
+		if line == "" {
+			continue
+		}
+		fields := strings.Split(line, ",")
+		if len(fields) != 5 {
+			return nil, fmt.Errorf("Unexpected delivery report line: %s", line)
+		}
+		sentTime, er := time.Parse(dateTimeLayout, strings.TrimSpace(fields[3]))
+		if er != nil {
+			return nil, er
+		}
+		deliveredTime, er := time.Parse(dateTimeLayout, strings.TrimSpace(fields[4]))
+		if er != nil {
+			return nil, er
+		}
+		statuses = append(statuses, DeliveryStatus{
+			MobileNumber:     strings.TrimSpace(fields[0]),
+			StatusCode:       strings.TrimSpace(fields[1]),
+			ErrorDescription: strings.TrimSpace(fields[2]),
+			SentTime:         sentTime,
+			DeliveredTime:    deliveredTime,
+		})
+	}
+	return statuses, nil
+}
@@ -0,0 +1,33 @@
+package smscountry
+
+// The GSM 03.38 default alphabet (basic and extension tables), used to
+// decide whether a message can be sent as NormalMessage or needs
+// UnicodeMessage encoding
+const (
+	gsm7BasicSet = "@£$¥èéùìòÇ\nØø\rÅåΔ_ΦΓΛΩΠΨΣΘΞ\x1bÆæßÉ !\"#¤%&'()*+,-./0123456789:;<=>?¡ABCDEFGHIJKLMNOPQRSTUVWXYZÄÖÑÜ§¿abcdefghijklmnopqrstuvwxyzäöñüà"
+	gsm7ExtSet   = "^{}\\[~]|€"
+)
+
+var gsm7Alphabet = buildGSM7Alphabet()
+
+func buildGSM7Alphabet() map[rune]bool {
+	alphabet := make(map[rune]bool, len(gsm7BasicSet)+len(gsm7ExtSet))
+	for _, r := range gsm7BasicSet {
+		alphabet[r] = true
+	}
+	for _, r := range gsm7ExtSet {
+		alphabet[r] = true
+	}
+	return alphabet
+}
+
+// isGSM7 reports whether message can be represented entirely in the
+// GSM 03.38 default alphabet
+func isGSM7(message string) bool {
+	for _, r := range message {
+		if !gsm7Alphabet[r] {
+			return false
+		}
+	}
+	return true
+}
@@ -0,0 +1,59 @@
+package smscountry
+
+import (
+	"context"
+
+	"github.com/v4run/smscountry/smsprovider"
+)
+
+// Sender implements smsprovider.Provider so applications can depend on
+// the gateway-agnostic interface instead of the smscountry types directly
+var _ smsprovider.Provider = (*Sender)(nil)
+
+// Send submits msg through SendWithOptions, mapping its gateway-agnostic
+// fields onto SendOptions, and returns a Receipt carrying the job ID,
+// satisfying smsprovider.Provider
+func (s *Sender) Send(ctx context.Context, msg smsprovider.Message) (smsprovider.Receipt, error) {
+	opts := SendOptions{
+		SenderID:    msg.From,
+		ScheduledAt: msg.Scheduled,
+	}
+	switch {
+	case msg.Flash:
+		opts.MessageType = FlashMessage
+	case msg.Unicode:
+		opts.MessageType = UnicodeMessage
+	}
+	jobID, err := s.SendWithOptions(ctx, msg.To, msg.Body, opts)
+	if err != nil {
+		return smsprovider.Receipt{}, err
+	}
+	return smsprovider.Receipt{ID: jobID}, nil
+}
+
+// Balance satisfies smsprovider.Provider by delegating to
+// Client.BalanceContext
+func (s *Sender) Balance(ctx context.Context) (float64, error) {
+	return s.Client.BalanceContext(ctx)
+}
+
+// FetchStatus satisfies smsprovider.Provider by delegating to
+// Client.FetchDeliveryReportContext and translating DeliveryStatus into
+// the gateway-agnostic smsprovider.Status
+func (s *Sender) FetchStatus(ctx context.Context, id string) ([]smsprovider.Status, error) {
+	statuses, err := s.Client.FetchDeliveryReportContext(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+	out := make([]smsprovider.Status, len(statuses))
+	for i, st := range statuses {
+		out[i] = smsprovider.Status{
+			To:          st.MobileNumber,
+			StatusCode:  st.StatusCode,
+			Description: st.ErrorDescription,
+			SentAt:      st.SentTime,
+			DeliveredAt: st.DeliveredTime,
+		}
+	}
+	return out, nil
+}
@@ -0,0 +1,37 @@
+package smscountry_test
+
+import (
+	"fmt"
+	"net/http"
+	"net/url"
+	"testing"
+
+	smscountry "github.com/v4run/smscountry"
+	"github.com/v4run/smscountry/smsprovider"
+	"github.com/v4run/smscountry/smsprovider/providertest"
+)
+
+func TestSenderSatisfiesProvider(t *testing.T) {
+	mux := http.NewServeMux()
+	mux.HandleFunc(smscountry.MessagePath, func(w http.ResponseWriter, r *http.Request) {
+		fmt.Fprint(w, "OK:12345")
+	})
+	mux.HandleFunc(smscountry.BalancePath, func(w http.ResponseWriter, r *http.Request) {
+		fmt.Fprint(w, "100.00 INR")
+	})
+	mux.HandleFunc(smscountry.BulkReportPath, func(w http.ResponseWriter, r *http.Request) {
+		fmt.Fprint(w, "919800000000,000,Delivered,01-01-2024 10:00:00,01-01-2024 10:00:05")
+	})
+	server := providertest.NewServer(mux)
+	defer server.Close()
+
+	serverURL, err := url.Parse(server.URL)
+	if err != nil {
+		t.Fatalf("parse server URL: %v", err)
+	}
+
+	client := smscountry.New("user", "pass", smscountry.WithBaseURL(serverURL.Scheme, serverURL.Host))
+	sender := client.NewSender("SENDER")
+
+	providertest.Exercise(t, sender, smsprovider.Message{To: "919800000000", Body: "hello"})
+}
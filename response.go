@@ -0,0 +1,81 @@
+package smscountry
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+)
+
+// StatusCode classifies a parsed Response
+type StatusCode int
+
+// Defines the possible StatusCode values a Response can carry
+const (
+	StatusOK StatusCode = iota
+	StatusInvalidCredentials
+	StatusInsufficientCredit
+	StatusInvalidSender
+	StatusInvalidMobile
+	StatusInternalError
+	StatusUnknown
+)
+
+// Defines the sentinel errors returned for each non-OK StatusCode, so
+// callers can branch with errors.Is instead of matching response strings
+var (
+	ErrInvalidCredentials = errors.New("smscountry: invalid credentials")
+	ErrInsufficientCredit = errors.New("smscountry: insufficient credit")
+	ErrInvalidSender      = errors.New("smscountry: invalid sender")
+	ErrInvalidMobile      = errors.New("smscountry: invalid mobile number")
+	ErrInternalError      = errors.New("smscountry: internal server error")
+)
+
+// Response is the parsed form of a raw SendSMS/SendBulkSMS response
+type Response struct {
+	StatusCode StatusCode
+	// JobID is set when the gateway answered "OK:<jobid>"; it is empty
+	// for the "SMS message(s) sent" success response
+	JobID string
+	// Raw is the untouched, trimmed response body
+	Raw string
+}
+
+// sentinelErrors maps each non-OK StatusCode to the sentinel error
+// parseResponse wraps it in
+var sentinelErrors = map[StatusCode]error{
+	StatusInvalidCredentials: ErrInvalidCredentials,
+	StatusInsufficientCredit: ErrInsufficientCredit,
+	StatusInvalidSender:      ErrInvalidSender,
+	StatusInvalidMobile:      ErrInvalidMobile,
+	StatusInternalError:      ErrInternalError,
+}
+
+// parseResponse classifies a trimmed SendSMS/SendBulkSMS response body.
+// On success it returns a Response with StatusOK, and with JobID populated
+// when the body has the "OK:<jobid>" form; on
+// a documented gateway failure it returns the matching sentinel error
+// wrapped with the raw response; otherwise it returns a plain error
+func parseResponse(raw string) (Response, error) {
+	switch {
+	case strings.HasPrefix(raw, "OK:"):
+		return Response{StatusCode: StatusOK, JobID: strings.TrimPrefix(raw, "OK:"), Raw: raw}, nil
+	case raw == "SMS message(s) sent":
+		return Response{StatusCode: StatusOK, Raw: raw}, nil
+	case strings.Contains(raw, "Invalid User Id") || strings.Contains(raw, "Invalid Password"):
+		return errorResponse(StatusInvalidCredentials, raw)
+	case strings.Contains(raw, "Insufficient Credit"):
+		return errorResponse(StatusInsufficientCredit, raw)
+	case strings.Contains(raw, "Invalid Sender"):
+		return errorResponse(StatusInvalidSender, raw)
+	case strings.Contains(raw, "Invalid Mobile"):
+		return errorResponse(StatusInvalidMobile, raw)
+	case strings.Contains(raw, "Internal Error") || strings.Contains(raw, "System Error"):
+		return errorResponse(StatusInternalError, raw)
+	default:
+		return Response{StatusCode: StatusUnknown, Raw: raw}, fmt.Errorf("smscountry: unrecognized response: %s", raw)
+	}
+}
+
+func errorResponse(code StatusCode, raw string) (Response, error) {
+	return Response{StatusCode: code, Raw: raw}, fmt.Errorf("%w: %s", sentinelErrors[code], raw)
+}
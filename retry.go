@@ -0,0 +1,81 @@
+package smscountry
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"math/rand"
+	"net"
+	"time"
+)
+
+// Default backoff parameters used by RetrySendSMS
+const (
+	defaultInitialBackoff = 200 * time.Millisecond
+	defaultJitter         = 100 * time.Millisecond
+)
+
+// Retryer controls how network calls are retried on transient failures
+type Retryer struct {
+	// MaxAttempts is the total number of attempts, including the first.
+	// A value <= 1 disables retrying
+	MaxAttempts int
+	// InitialBackoff is the delay before the second attempt; it doubles
+	// on each subsequent attempt
+	InitialBackoff time.Duration
+	// Jitter adds up to this much additional random delay to each backoff
+	Jitter time.Duration
+}
+
+// httpStatusError is returned by network calls when the gateway responds
+// with a 5xx status, so that Retryer can recognize it as retryable
+type httpStatusError struct {
+	StatusCode int
+}
+
+func (e *httpStatusError) Error() string {
+	return fmt.Sprintf("smscountry: server returned status %d", e.StatusCode)
+}
+
+// isRetryable reports whether err represents a transient failure worth
+// retrying: a 5xx response or a transport-level network error
+func isRetryable(err error) bool {
+	var statusErr *httpStatusError
+	if errors.As(err, &statusErr) {
+		return statusErr.StatusCode >= 500
+	}
+	var netErr net.Error
+	if errors.As(err, &netErr) {
+		return netErr.Timeout() || netErr.Temporary()
+	}
+	return false
+}
+
+// retryDo runs fn, retrying according to r while ctx is not done. A nil r
+// (or one with MaxAttempts <= 1) runs fn exactly once
+func retryDo(ctx context.Context, r *Retryer, fn func() error) error {
+	attempts := 1
+	var backoff, jitter time.Duration
+	if r != nil && r.MaxAttempts > 1 {
+		attempts = r.MaxAttempts
+		backoff = r.InitialBackoff
+		jitter = r.Jitter
+	}
+	var err error
+	for attempt := 0; attempt < attempts; attempt++ {
+		err = fn()
+		if err == nil || !isRetryable(err) || attempt == attempts-1 {
+			return err
+		}
+		wait := backoff * time.Duration(int64(1)<<uint(attempt))
+		if jitter > 0 {
+			wait += time.Duration(rand.Int63n(int64(jitter)))
+		}
+		select {
+		case <-ctx.Done():
+			return ctx.Err()
+		case <-time.After(wait):
+		}
+	}
+	return err
+}
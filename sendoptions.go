@@ -0,0 +1,111 @@
+package smscountry
+
+import (
+	"context"
+	"net/url"
+	"time"
+)
+
+// Defines the keys for the optional parameters accepted by SendWithOptions
+const (
+	WAPURLKey         = "wap_url"
+	ScheduledAtKey    = "scheduletime"
+	CustomIDKey       = "customid"
+	DLRCallbackURLKey = "dlr_url"
+)
+
+// scheduledAtLayout is the timestamp format SMS country expects for
+// scheduled sends
+const scheduledAtLayout = "02/01/2006 15:04"
+
+// Single-segment length limits before a message must be sent as LongSMS
+const (
+	gsm7MaxSingleSegment = 160
+	ucs2MaxSingleSegment = 70
+)
+
+// SendOptions controls the optional parameters of SendWithOptions. The
+// zero value sends a normal, unscheduled message with an auto-detected
+// MessageType
+type SendOptions struct {
+	// MessageType pins the mtype parameter (see the message type
+	// constants). Left empty, it is auto-detected: Unicode for non-GSM-7
+	// content, LongSMS for anything exceeding a single segment, otherwise
+	// NormalMessage
+	MessageType string
+	// SenderID overrides the Sender's configured SenderID for this
+	// message. Left empty, the Sender's SenderID is used
+	SenderID string
+	// WAPURL is required when MessageType is WAPPush
+	WAPURL string
+	// ScheduledAt submits the message for later delivery. The zero value
+	// sends immediately
+	ScheduledAt time.Time
+	// CustomID is echoed back by the gateway on delivery reports so
+	// callers can correlate without tracking the returned job ID
+	CustomID string
+	// DLRCallbackURL overrides the account's configured delivery report
+	// callback URL for this message and implies DeliveryReport
+	DLRCallbackURL string
+}
+
+// detectMessageType picks an mtype for message based on its encoding and
+// length when the caller hasn't pinned one
+func detectMessageType(message string) string {
+	maxLen := gsm7MaxSingleSegment
+	unicode := !isGSM7(message)
+	if unicode {
+		maxLen = ucs2MaxSingleSegment
+	}
+	if len([]rune(message)) > maxLen {
+		return LongSMS
+	}
+	if unicode {
+		return UnicodeMessage
+	}
+	return NormalMessage
+}
+
+// SendWithOptions sends message to mobileNumber with the given opts,
+// returning the job ID parsed out of the gateway's "OK:<jobid>" response
+func (s *Sender) SendWithOptions(ctx context.Context, mobileNumber, message string, opts SendOptions) (jobID string, err error) {
+	senderID := opts.SenderID
+	if senderID == "" {
+		senderID = s.SenderID
+	}
+
+	query := url.Values{}
+	query.Add(User, s.Client.User)
+	query.Add(Password, s.Client.Password)
+	query.Add(SenderID, senderID)
+	query.Add(MobileNumber, mobileNumber)
+	query.Add(Message, message)
+
+	mtype := opts.MessageType
+	if mtype == "" {
+		mtype = detectMessageType(message)
+	}
+	query.Add(MessageType, mtype)
+
+	if opts.WAPURL != "" {
+		query.Add(WAPURLKey, opts.WAPURL)
+	}
+	if !opts.ScheduledAt.IsZero() {
+		query.Add(ScheduledAtKey, opts.ScheduledAt.Format(scheduledAtLayout))
+	}
+	if opts.CustomID != "" {
+		query.Add(CustomIDKey, opts.CustomID)
+	}
+	if opts.DLRCallbackURL != "" {
+		query.Add(DLRCallbackURLKey, opts.DLRCallbackURL)
+		query.Add(DeliveryReport, SendDeliveryReport)
+	} else {
+		query.Add(DeliveryReport, DontSendDeliveryReport)
+	}
+
+	response, err := s.sendMessage(ctx, s.Client.retryer, MessagePath, query.Encode())
+	if err != nil {
+		return "", err
+	}
+	return response.JobID, nil
+}
@@ -2,9 +2,9 @@ package smscountry
 
 import (
 	"bytes"
+	"context"
 	"errors"
 	"fmt"
-	"io"
 	"io/ioutil"
 	"net/http"
 	"net/url"
@@ -61,35 +61,84 @@ var (
 
 // Client defines a sms country client
 type Client struct {
-	User              string
-	Password          string
-	balanceEnquiryURL string
-	httpClient        *http.Client
+	User       string
+	Password   string
+	scheme     string
+	host       string
+	httpClient *http.Client
+	retryer    *Retryer
+}
+
+// Option configures a Client returned by New
+type Option func(*Client)
+
+// WithRetryer makes the Client retry Balance, SendSMS and SendBulkSMS (and
+// their Context variants) on 5xx responses and transient transport errors,
+// according to r
+func WithRetryer(r Retryer) Option {
+	return func(c *Client) {
+		c.retryer = &r
+	}
+}
+
+// WithBaseURL points the Client at scheme/host instead of
+// SMSCountryScheme/SMSCountryHost, so it can be aimed at a fake server
+// (e.g. in tests via providertest.NewServer)
+func WithBaseURL(scheme, host string) Option {
+	return func(c *Client) {
+		c.scheme = scheme
+		c.host = host
+	}
+}
+
+// balanceEnquiryURL builds the URL used by Balance/BalanceContext
+func (s Client) balanceEnquiryURL() string {
+	return (&url.URL{
+		Host:   s.host,
+		Path:   BalancePath,
+		Scheme: s.scheme,
+		RawQuery: url.Values{
+			User:     {s.User},
+			Password: {s.Password},
+		}.Encode(),
+	}).String()
 }
 
 // Balance returns the balance available for the user
 func (s Client) Balance() (bal float64, err error) {
-	if resp, er := s.httpClient.Get(s.balanceEnquiryURL); er != nil {
-		err = er
-	} else {
+	return s.BalanceContext(context.Background())
+}
+
+// BalanceContext is like Balance but observes ctx cancellation, both for
+// the request itself and between retry attempts
+func (s Client) BalanceContext(ctx context.Context) (bal float64, err error) {
+	err = retryDo(ctx, s.retryer, func() error {
+		req, er := http.NewRequestWithContext(ctx, http.MethodGet, s.balanceEnquiryURL(), nil)
+		if er != nil {
+			return er
+		}
+		resp, er := s.httpClient.Do(req)
+		if er != nil {
+			return er
+		}
 		if resp.Body == nil {
-			err = ErrEmptyResponse
+			return ErrEmptyResponse
 		}
-		defer func(e *error) {
-			if err := resp.Body.Close(); err != nil {
-				*e = err
-			}
-		}(&err)
-		if r, er := ioutil.ReadAll(resp.Body); er != nil {
-			err = er
-		} else {
-			if v, er := strconv.ParseFloat(strings.SplitN(string(r), " ", 2)[0], 64); er != nil {
-				err = er
-			} else {
-				bal = v
-			}
+		defer resp.Body.Close()
+		if resp.StatusCode >= http.StatusInternalServerError {
+			return &httpStatusError{StatusCode: resp.StatusCode}
 		}
-	}
+		r, er := ioutil.ReadAll(resp.Body)
+		if er != nil {
+			return er
+		}
+		v, er := strconv.ParseFloat(strings.SplitN(string(r), " ", 2)[0], 64)
+		if er != nil {
+			return er
+		}
+		bal = v
+		return nil
+	})
 	return bal, err
 }
 
@@ -107,40 +156,97 @@ type Sender struct {
 	SenderID string
 }
 
-func (s *Sender) sendMessage(path string, content io.Reader) (err error) {
-	if resp, er := s.Client.httpClient.Post((&url.URL{
-		Host:   SMSCountryHost,
-		Path:   path,
-		Scheme: SMSCountryScheme,
-	}).String(), "application/x-www-form-urlencoded", content); er != nil {
-		err = er
-	} else {
-		if resp.Body == nil {
+// sendMessage posts body to path, retrying per retryer, and returns the
+// parsed Response on success
+func (s *Sender) sendMessage(ctx context.Context, retryer *Retryer, path, body string) (response Response, err error) {
+	err = retryDo(ctx, retryer, func() (ferr error) {
+		req, er := http.NewRequestWithContext(ctx, http.MethodPost, (&url.URL{
+			Host:   s.Client.host,
+			Path:   path,
+			Scheme: s.Client.scheme,
+		}).String(), strings.NewReader(body))
+		if er != nil {
+			return er
+		}
+		req.Header.Set("Content-Type", "application/x-www-form-urlencoded")
+		httpResp, er := s.Client.httpClient.Do(req)
+		if er != nil {
+			return er
+		}
+		if httpResp.Body == nil {
 			return ErrEmptyResponse
 		}
-		defer func(e *error) {
-			if err := resp.Body.Close(); err != nil {
-				if e == nil || *e == nil {
-					*e = err
+		defer func() {
+			if cerr := httpResp.Body.Close(); cerr != nil {
+				if ferr == nil {
+					ferr = cerr
 				} else {
-					*e = fmt.Errorf("Error: %v, Body close error: %v", *e, err)
+					ferr = fmt.Errorf("Error: %v, Body close error: %v", ferr, cerr)
 				}
 			}
-		}(&err)
-		if r, er := ioutil.ReadAll(resp.Body); er != nil {
-			err = er
-		} else {
-			response := strings.TrimSpace(string(r))
-			if !strings.HasPrefix(response, "OK:") && response != "SMS message(s) sent" {
-				return fmt.Errorf("Error sending SMS. Response: %s", response)
-			}
+		}()
+		if httpResp.StatusCode >= http.StatusInternalServerError {
+			return &httpStatusError{StatusCode: httpResp.StatusCode}
 		}
-	}
-	return nil
+		r, er := ioutil.ReadAll(httpResp.Body)
+		if er != nil {
+			return er
+		}
+		parsed, er := parseResponse(strings.TrimSpace(string(r)))
+		if er != nil {
+			return er
+		}
+		response = parsed
+		return nil
+	})
+	return response, err
 }
 
 // SendSMS sends an SMS to the recipient
 func (s *Sender) SendSMS(message, mobileNumber string, deliveryReport bool) (err error) {
+	return s.SendSMSContext(context.Background(), message, mobileNumber, deliveryReport)
+}
+
+// SendSMSContext is like SendSMS but observes ctx cancellation, both for
+// the request itself and between retry attempts
+func (s *Sender) SendSMSContext(ctx context.Context, message, mobileNumber string, deliveryReport bool) (err error) {
+	_, err = s.SendSMSWithJobIDContext(ctx, message, mobileNumber, deliveryReport)
+	return err
+}
+
+// SendSMSWithJobID sends an SMS to the recipient and returns the job ID
+// parsed out of the gateway's "OK:<jobid>" response, which can later be
+// passed to Client.FetchDeliveryReport
+func (s *Sender) SendSMSWithJobID(message, mobileNumber string, deliveryReport bool) (jobID string, err error) {
+	return s.SendSMSWithJobIDContext(context.Background(), message, mobileNumber, deliveryReport)
+}
+
+// SendSMSWithJobIDContext is like SendSMSWithJobID but observes ctx
+// cancellation, both for the request itself and between retry attempts
+func (s *Sender) SendSMSWithJobIDContext(ctx context.Context, message, mobileNumber string, deliveryReport bool) (jobID string, err error) {
+	query := url.Values{}
+	query.Add(User, s.Client.User)
+	query.Add(Password, s.Client.Password)
+	query.Add(SenderID, s.SenderID)
+	query.Add(MobileNumber, mobileNumber)
+	query.Add(Message, message)
+	query.Add(MessageType, NormalMessage)
+	if deliveryReport {
+		query.Add(DeliveryReport, SendDeliveryReport)
+	} else {
+		query.Add(DeliveryReport, DontSendDeliveryReport)
+	}
+	response, err := s.sendMessage(ctx, s.Client.retryer, MessagePath, query.Encode())
+	if err != nil {
+		return "", err
+	}
+	return response.JobID, nil
+}
+
+// RetrySendSMS sends an SMS to the recipient, making at most attempts
+// attempts in total (the first included) while it gets 5xx responses or
+// transient transport errors, honoring ctx.Done() between attempts
+func (s *Sender) RetrySendSMS(ctx context.Context, attempts int, message, mobileNumber string, deliveryReport bool) (err error) {
 	query := url.Values{}
 	query.Add(User, s.Client.User)
 	query.Add(Password, s.Client.Password)
@@ -153,11 +259,37 @@ func (s *Sender) SendSMS(message, mobileNumber string, deliveryReport bool) (err
 	} else {
 		query.Add(DeliveryReport, DontSendDeliveryReport)
 	}
-	return s.sendMessage(MessagePath, strings.NewReader(query.Encode()))
+	_, err = s.sendMessage(ctx, &Retryer{
+		MaxAttempts:    attempts,
+		InitialBackoff: defaultInitialBackoff,
+		Jitter:         defaultJitter,
+	}, MessagePath, query.Encode())
+	return err
 }
 
 // SendBulkSMS sends an SMS to the recipient
 func (s *Sender) SendBulkSMS(messages, mobileNumbers []string, deliveryReport bool) (err error) {
+	return s.SendBulkSMSContext(context.Background(), messages, mobileNumbers, deliveryReport)
+}
+
+// SendBulkSMSContext is like SendBulkSMS but observes ctx cancellation,
+// both for the request itself and between retry attempts
+func (s *Sender) SendBulkSMSContext(ctx context.Context, messages, mobileNumbers []string, deliveryReport bool) (err error) {
+	_, err = s.SendBulkSMSWithJobIDContext(ctx, messages, mobileNumbers, deliveryReport)
+	return err
+}
+
+// SendBulkSMSWithJobID sends messages[i] to mobileNumbers[i] in a single
+// request and returns the job ID
+// parsed out of the gateway's "OK:<jobid>" response, which can later be
+// passed to Client.FetchDeliveryReport
+func (s *Sender) SendBulkSMSWithJobID(messages, mobileNumbers []string, deliveryReport bool) (jobID string, err error) {
+	return s.SendBulkSMSWithJobIDContext(context.Background(), messages, mobileNumbers, deliveryReport)
+}
+
+// SendBulkSMSWithJobIDContext is like SendBulkSMSWithJobID but observes
+// ctx cancellation, both for the request itself and between retry attempts
+func (s *Sender) SendBulkSMSWithJobIDContext(ctx context.Context, messages, mobileNumbers []string, deliveryReport bool) (jobID string, err error) {
 	msgBuf := new(bytes.Buffer)
 	msgBuf.WriteString(fmt.Sprintf("%s^%s", mobileNumbers[0], messages[0]))
 	for i := 1; i < len(messages); i++ {
@@ -169,24 +301,24 @@ func (s *Sender) SendBulkSMS(messages, mobileNumbers []string, deliveryReport bo
 	query.Add(SenderID, s.SenderID)
 	query.Add(MultiMessage, msgBuf.String())
 	query.Add(MessageType, NormalMessage)
-	return s.sendMessage(MultiMessagePath, strings.NewReader(query.Encode()))
+	response, err := s.sendMessage(ctx, s.Client.retryer, MultiMessagePath, query.Encode())
+	if err != nil {
+		return "", err
+	}
+	return response.JobID, nil
 }
 
 // New returns a new instance of Client
-func New(user, password string) *Client {
+func New(user, password string, opts ...Option) *Client {
 	s := &Client{
-		User:     user,
-		Password: password,
-		balanceEnquiryURL: (&url.URL{
-			Host:   SMSCountryHost,
-			Path:   BalancePath,
-			Scheme: SMSCountryScheme,
-			RawQuery: url.Values{
-				"User":   {user},
-				"passwd": {password},
-			}.Encode(),
-		}).String(),
+		User:       user,
+		Password:   password,
+		scheme:     SMSCountryScheme,
+		host:       SMSCountryHost,
 		httpClient: &http.Client{Timeout: time.Duration(time.Minute)},
 	}
+	for _, opt := range opts {
+		opt(s)
+	}
 	return s
 }
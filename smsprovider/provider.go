@@ -0,0 +1,48 @@
+// Package smsprovider defines a provider-agnostic interface for sending
+// SMS messages, so that applications can depend on Provider rather than
+// on a specific gateway's client. Concrete drivers (smscountry and
+// others) implement Provider in their own packages.
+package smsprovider
+
+import (
+	"context"
+	"time"
+)
+
+// Message is a gateway-agnostic SMS to be sent through a Provider
+type Message struct {
+	To        string
+	From      string
+	Body      string
+	Unicode   bool
+	Flash     bool
+	Scheduled time.Time
+}
+
+// Receipt is returned by Provider.Send on success and identifies the
+// submitted message so its terminal status can be queried later
+type Receipt struct {
+	ID string
+}
+
+// Status is the terminal delivery status of a previously sent message
+type Status struct {
+	To          string
+	StatusCode  string
+	Description string
+	SentAt      time.Time
+	DeliveredAt time.Time
+}
+
+// Provider is implemented by SMS gateway drivers. Applications compose
+// against Provider so they can swap gateways (or a mock) without
+// changing call sites
+type Provider interface {
+	// Send submits msg for delivery and returns a Receipt identifying it
+	Send(ctx context.Context, msg Message) (Receipt, error)
+	// Balance returns the account balance available to send further messages
+	Balance(ctx context.Context) (float64, error)
+	// FetchStatus returns the delivery status of every message submitted
+	// under the Receipt.ID returned by Send
+	FetchStatus(ctx context.Context, id string) ([]Status, error)
+}
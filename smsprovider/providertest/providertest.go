@@ -0,0 +1,40 @@
+// Package providertest offers a small conformance suite for
+// smsprovider.Provider implementations, so drivers can be exercised
+// against a fake HTTP server from their own package's tests.
+package providertest
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/v4run/smscountry/smsprovider"
+)
+
+// NewServer starts an httptest.Server driven by handler. Point the
+// Provider implementation under test at server.URL before calling
+// Exercise against it
+func NewServer(handler http.Handler) *httptest.Server {
+	return httptest.NewServer(handler)
+}
+
+// Exercise calls Send, Balance and FetchStatus on p and fails t if any of
+// them return an error, verifying p satisfies the basic Provider contract
+func Exercise(t *testing.T, p smsprovider.Provider, msg smsprovider.Message) {
+	t.Helper()
+	ctx := context.Background()
+
+	receipt, err := p.Send(ctx, msg)
+	if err != nil {
+		t.Fatalf("Send: %v", err)
+	}
+
+	if _, err := p.Balance(ctx); err != nil {
+		t.Fatalf("Balance: %v", err)
+	}
+
+	if _, err := p.FetchStatus(ctx, receipt.ID); err != nil {
+		t.Fatalf("FetchStatus: %v", err)
+	}
+}
@@ -0,0 +1,80 @@
+package webhook
+
+import (
+	"net/http"
+	"time"
+
+	"github.com/v4run/smscountry"
+)
+
+// deliveryReportTimeLayout is the timestamp format SMS country uses in
+// delivery-report callbacks
+const deliveryReportTimeLayout = "02-01-2006 15:04:05"
+
+// Defines the extra form keys used by the delivery-report callback that
+// aren't already declared in the parent package
+const (
+	statusCodeKey    = "status"
+	descriptionKey   = "description"
+	deliveredTimeKey = "deliveredtime"
+)
+
+// DeliveryReport is the parsed form of a delivery-report callback
+type DeliveryReport struct {
+	JobID         string
+	MobileNumber  string
+	StatusCode    string
+	Description   string
+	DeliveredTime time.Time
+}
+
+// DeliveryReportFunc is called with each successfully parsed and
+// authenticated DeliveryReport
+type DeliveryReportFunc func(DeliveryReport)
+
+// DeliveryReportHandler is an http.Handler that verifies, parses and
+// dispatches delivery-report callbacks to Callback
+type DeliveryReportHandler struct {
+	Credentials Credentials
+	Callback    DeliveryReportFunc
+}
+
+// DeliveryReportHandlerFunc adapts creds and fn into an http.HandlerFunc,
+// for callers that would rather not declare a DeliveryReportHandler value
+func DeliveryReportHandlerFunc(creds Credentials, fn DeliveryReportFunc) http.HandlerFunc {
+	return DeliveryReportHandler{Credentials: creds, Callback: fn}.ServeHTTP
+}
+
+// ServeHTTP implements http.Handler, accepting both GET and POST
+// form-encoded callbacks
+func (h DeliveryReportHandler) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	if err := r.ParseForm(); err != nil {
+		http.Error(w, err.Error(), http.StatusBadRequest)
+		return
+	}
+	if err := h.Credentials.verify(r); err != nil {
+		http.Error(w, err.Error(), http.StatusUnauthorized)
+		return
+	}
+	report, err := parseDeliveryReport(r)
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusBadRequest)
+		return
+	}
+	h.Callback(report)
+	w.WriteHeader(http.StatusOK)
+}
+
+func parseDeliveryReport(r *http.Request) (DeliveryReport, error) {
+	deliveredTime, err := time.Parse(deliveryReportTimeLayout, r.FormValue(deliveredTimeKey))
+	if err != nil {
+		return DeliveryReport{}, err
+	}
+	return DeliveryReport{
+		JobID:         r.FormValue(smscountry.JobID),
+		MobileNumber:  r.FormValue(smscountry.MobileNumber),
+		StatusCode:    r.FormValue(statusCodeKey),
+		Description:   r.FormValue(descriptionKey),
+		DeliveredTime: deliveredTime,
+	}, nil
+}
@@ -0,0 +1,78 @@
+package webhook
+
+import (
+	"net/http"
+	"time"
+
+	"github.com/v4run/smscountry"
+)
+
+// inboundTimeLayout is the timestamp format SMS country uses in inbound
+// (MO) callbacks
+const inboundTimeLayout = "02-01-2006 15:04:05"
+
+// Defines the extra form keys used by the inbound-MO callback that
+// aren't already declared in the parent package
+const (
+	fromKey     = "mobileno"
+	toKey       = "to"
+	receivedKey = "timestamp"
+)
+
+// InboundMessage is the parsed form of an inbound-MO callback
+type InboundMessage struct {
+	From       string
+	To         string
+	Message    string
+	ReceivedAt time.Time
+}
+
+// InboundMessageFunc is called with each successfully parsed and
+// authenticated InboundMessage
+type InboundMessageFunc func(InboundMessage)
+
+// InboundMessageHandler is an http.Handler that verifies, parses and
+// dispatches inbound-MO callbacks to Callback
+type InboundMessageHandler struct {
+	Credentials Credentials
+	Callback    InboundMessageFunc
+}
+
+// InboundMessageHandlerFunc adapts creds and fn into an http.HandlerFunc,
+// for callers that would rather not declare an InboundMessageHandler value
+func InboundMessageHandlerFunc(creds Credentials, fn InboundMessageFunc) http.HandlerFunc {
+	return InboundMessageHandler{Credentials: creds, Callback: fn}.ServeHTTP
+}
+
+// ServeHTTP implements http.Handler, accepting both GET and POST
+// form-encoded callbacks
+func (h InboundMessageHandler) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	if err := r.ParseForm(); err != nil {
+		http.Error(w, err.Error(), http.StatusBadRequest)
+		return
+	}
+	if err := h.Credentials.verify(r); err != nil {
+		http.Error(w, err.Error(), http.StatusUnauthorized)
+		return
+	}
+	msg, err := parseInboundMessage(r)
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusBadRequest)
+		return
+	}
+	h.Callback(msg)
+	w.WriteHeader(http.StatusOK)
+}
+
+func parseInboundMessage(r *http.Request) (InboundMessage, error) {
+	receivedAt, err := time.Parse(inboundTimeLayout, r.FormValue(receivedKey))
+	if err != nil {
+		return InboundMessage{}, err
+	}
+	return InboundMessage{
+		From:       r.FormValue(fromKey),
+		To:         r.FormValue(toKey),
+		Message:    r.FormValue(smscountry.Message),
+		ReceivedAt: receivedAt,
+	}, nil
+}
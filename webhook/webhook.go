@@ -0,0 +1,41 @@
+// Package webhook parses the delivery-report and inbound-MO callbacks
+// SMS country posts to applications and dispatches them to
+// user-registered callbacks, mirroring the Sender/Client request shapes
+// in the parent package.
+package webhook
+
+import (
+	"errors"
+	"net/http"
+
+	"github.com/v4run/smscountry"
+)
+
+// ErrUnauthorized is returned when the incoming request's credentials
+// don't match the configured Credentials
+var ErrUnauthorized = errors.New("webhook: unauthorized")
+
+// ErrNoCredentials is returned when a Handler is configured with an empty
+// Credentials, which would otherwise match any request that omits the
+// User/Password form fields
+var ErrNoCredentials = errors.New("webhook: no credentials configured")
+
+// Credentials are checked against the User/Password form values SMS
+// country includes on every callback request. Both fields are required;
+// a zero-value Credentials never authenticates
+type Credentials struct {
+	User     string
+	Password string
+}
+
+// verify reports whether r carries c's credentials. r.ParseForm must have
+// been called first
+func (c Credentials) verify(r *http.Request) error {
+	if c.User == "" || c.Password == "" {
+		return ErrNoCredentials
+	}
+	if r.FormValue(smscountry.User) != c.User || r.FormValue(smscountry.Password) != c.Password {
+		return ErrUnauthorized
+	}
+	return nil
+}
@@ -0,0 +1,91 @@
+package webhook_test
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"strings"
+	"testing"
+
+	"github.com/v4run/smscountry/webhook"
+)
+
+func TestDeliveryReportHandler(t *testing.T) {
+	creds := webhook.Credentials{User: "user", Password: "pass"}
+	var got webhook.DeliveryReport
+	handler := webhook.DeliveryReportHandlerFunc(creds, func(dr webhook.DeliveryReport) {
+		got = dr
+	})
+	server := httptest.NewServer(handler)
+	defer server.Close()
+
+	form := url.Values{
+		"User":          {"user"},
+		"passwd":        {"pass"},
+		"jobid":         {"123"},
+		"mobilenumber":  {"919800000000"},
+		"status":        {"000"},
+		"description":   {"Delivered"},
+		"deliveredtime": {"01-01-2024 10:00:00"},
+	}
+	resp, err := http.PostForm(server.URL, form)
+	if err != nil {
+		t.Fatalf("PostForm: %v", err)
+	}
+	defer resp.Body.Close()
+	if resp.StatusCode != http.StatusOK {
+		t.Fatalf("status = %d, want %d", resp.StatusCode, http.StatusOK)
+	}
+	if got.JobID != "123" || got.MobileNumber != "919800000000" {
+		t.Fatalf("got %+v", got)
+	}
+}
+
+func TestDeliveryReportHandlerRejectsBadCredentials(t *testing.T) {
+	creds := webhook.Credentials{User: "user", Password: "pass"}
+	handler := webhook.DeliveryReportHandlerFunc(creds, func(webhook.DeliveryReport) {
+		t.Fatal("callback should not run for unauthorized requests")
+	})
+	server := httptest.NewServer(handler)
+	defer server.Close()
+
+	resp, err := http.Get(server.URL + "?" + url.Values{
+		"jobid": {"123"},
+	}.Encode())
+	if err != nil {
+		t.Fatalf("Get: %v", err)
+	}
+	defer resp.Body.Close()
+	if resp.StatusCode != http.StatusUnauthorized {
+		t.Fatalf("status = %d, want %d", resp.StatusCode, http.StatusUnauthorized)
+	}
+}
+
+func TestInboundMessageHandler(t *testing.T) {
+	creds := webhook.Credentials{User: "user", Password: "pass"}
+	var got webhook.InboundMessage
+	handler := webhook.InboundMessageHandlerFunc(creds, func(msg webhook.InboundMessage) {
+		got = msg
+	})
+	server := httptest.NewServer(handler)
+	defer server.Close()
+
+	resp, err := http.Get(server.URL + "?" + url.Values{
+		"User":      {"user"},
+		"passwd":    {"pass"},
+		"mobileno":  {"919800000000"},
+		"to":        {"1234"},
+		"message":   {"hi there"},
+		"timestamp": {"01-01-2024 10:00:00"},
+	}.Encode())
+	if err != nil {
+		t.Fatalf("Get: %v", err)
+	}
+	defer resp.Body.Close()
+	if resp.StatusCode != http.StatusOK {
+		t.Fatalf("status = %d, want %d", resp.StatusCode, http.StatusOK)
+	}
+	if got.From != "919800000000" || !strings.Contains(got.Message, "hi") {
+		t.Fatalf("got %+v", got)
+	}
+}